@@ -0,0 +1,104 @@
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/DazaSCM/GolangCURD/pkg/config"
+	"github.com/DazaSCM/GolangCURD/pkg/handlers"
+	"github.com/DazaSCM/GolangCURD/pkg/migrate"
+	"github.com/DazaSCM/GolangCURD/pkg/store"
+)
+
+func main() {
+	cfg, err := config.Load("config.toml")
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	var s store.UserStore
+	switch cfg.Driver {
+	case "sqlite":
+		sqliteStore, err := store.NewSQLiteStore(cfg.SQLitePath)
+		if err != nil {
+			log.Fatalf("failed to initialize store: %v", err)
+		}
+		s = sqliteStore
+	case "mysql", "":
+		db, err := sql.Open("mysql", cfg.DSN())
+		if err != nil {
+			log.Fatalf("failed to open database: %v", err)
+		}
+		defer db.Close()
+
+		db.SetMaxOpenConns(cfg.MaxOpenConns)
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+		db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+
+		if err := db.Ping(); err != nil {
+			log.Fatalf("failed to connect to database: %v", err)
+		}
+
+		if len(os.Args) > 1 && os.Args[1] == "migrate" {
+			runMigrateCommand(db, os.Args[2:])
+			return
+		}
+
+		autoMigrate := flag.Bool("auto-migrate", false, "run pending migrations on startup")
+		flag.Parse()
+
+		if *autoMigrate {
+			if err := migrate.Up(db); err != nil {
+				log.Fatalf("failed to auto-migrate: %v", err)
+			}
+		}
+
+		mysqlStore, err := store.NewMySQLStore(db)
+		if err != nil {
+			log.Fatalf("failed to initialize store: %v", err)
+		}
+		s = mysqlStore
+	default:
+		log.Fatalf("unknown database driver %q", cfg.Driver)
+	}
+
+	logger := log.New(os.Stdout, "", log.LstdFlags)
+	app := handlers.NewApp(s, logger, cfg.SessionSecret)
+
+	addr := fmt.Sprintf(":%d", cfg.ServerPort)
+	logger.Printf("Server listening on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, app.Router))
+}
+
+func runMigrateCommand(db *sql.DB, args []string) {
+	if len(args) < 1 {
+		log.Fatal("usage: gocrud migrate up|down|status")
+	}
+
+	switch args[0] {
+	case "up":
+		if err := migrate.Up(db); err != nil {
+			log.Fatalf("migrate up: %v", err)
+		}
+		log.Println("migrations applied")
+	case "down":
+		if err := migrate.Down(db); err != nil {
+			log.Fatalf("migrate down: %v", err)
+		}
+		log.Println("migrations reverted")
+	case "status":
+		version, dirty, err := migrate.Status(db)
+		if err != nil {
+			log.Fatalf("migrate status: %v", err)
+		}
+		fmt.Printf("version=%d dirty=%v\n", version, dirty)
+	default:
+		log.Fatalf("unknown migrate subcommand %q", args[0])
+	}
+}
@@ -0,0 +1,211 @@
+// Package config loads the settings needed to wire up the store and
+// HTTP server at startup, combining a config.toml file with environment
+// variable overrides.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Config holds the settings needed to construct a store.UserStore and
+// start the HTTP server.
+type Config struct {
+	// Driver selects the store backend: "mysql" (default) or "sqlite".
+	Driver string
+
+	DBHost     string
+	DBPort     int
+	DBUser     string
+	DBPassword string
+	DBName     string
+
+	// SQLitePath is the DSN passed to store.NewSQLiteStore when Driver
+	// is "sqlite" (e.g. "./gocrud.db" or ":memory:").
+	SQLitePath string
+
+	ServerPort int
+
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+
+	// SessionSecret signs and encrypts the session cookie. Override it
+	// in production via the SESSION_SECRET environment variable.
+	SessionSecret string
+}
+
+// fileConfig mirrors the config.toml layout.
+type fileConfig struct {
+	Database struct {
+		Driver     string `toml:"driver"`
+		Host       string `toml:"host"`
+		Port       int    `toml:"port"`
+		User       string `toml:"user"`
+		Password   string `toml:"password"`
+		Name       string `toml:"name"`
+		SQLitePath string `toml:"sqlite_path"`
+	} `toml:"database"`
+	Server struct {
+		Port int `toml:"port"`
+	} `toml:"server"`
+	Pool struct {
+		MaxOpenConns    int    `toml:"max_open_conns"`
+		MaxIdleConns    int    `toml:"max_idle_conns"`
+		ConnMaxLifetime string `toml:"conn_max_lifetime"`
+	} `toml:"pool"`
+	Session struct {
+		Secret string `toml:"secret"`
+	} `toml:"session"`
+}
+
+func defaults() Config {
+	return Config{
+		Driver:          "mysql",
+		DBHost:          "127.0.0.1",
+		DBPort:          3306,
+		DBUser:          "root",
+		DBPassword:      "root",
+		DBName:          "gocrud_app",
+		SQLitePath:      "./gocrud.db",
+		ServerPort:      8080,
+		MaxOpenConns:    25,
+		MaxIdleConns:    25,
+		ConnMaxLifetime: 5 * time.Minute,
+		SessionSecret:   "dev-insecure-session-secret",
+	}
+}
+
+// Load builds a Config by starting from built-in defaults, applying
+// path (a config.toml file, ignored if missing), and then applying
+// environment variable overrides. Environment variables always win so
+// deployments can override the checked-in file without editing it.
+func Load(path string) (Config, error) {
+	cfg := defaults()
+
+	if data, err := os.ReadFile(path); err == nil {
+		var fc fileConfig
+		if _, err := toml.Decode(string(data), &fc); err != nil {
+			return Config{}, fmt.Errorf("parse %s: %w", path, err)
+		}
+		applyFileConfig(&cfg, fc)
+	} else if !os.IsNotExist(err) {
+		return Config{}, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	if err := applyEnv(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func applyFileConfig(cfg *Config, fc fileConfig) {
+	if fc.Database.Driver != "" {
+		cfg.Driver = fc.Database.Driver
+	}
+	if fc.Database.SQLitePath != "" {
+		cfg.SQLitePath = fc.Database.SQLitePath
+	}
+	if fc.Database.Host != "" {
+		cfg.DBHost = fc.Database.Host
+	}
+	if fc.Database.Port != 0 {
+		cfg.DBPort = fc.Database.Port
+	}
+	if fc.Database.User != "" {
+		cfg.DBUser = fc.Database.User
+	}
+	if fc.Database.Password != "" {
+		cfg.DBPassword = fc.Database.Password
+	}
+	if fc.Database.Name != "" {
+		cfg.DBName = fc.Database.Name
+	}
+	if fc.Server.Port != 0 {
+		cfg.ServerPort = fc.Server.Port
+	}
+	if fc.Pool.MaxOpenConns != 0 {
+		cfg.MaxOpenConns = fc.Pool.MaxOpenConns
+	}
+	if fc.Pool.MaxIdleConns != 0 {
+		cfg.MaxIdleConns = fc.Pool.MaxIdleConns
+	}
+	if fc.Pool.ConnMaxLifetime != "" {
+		if d, err := time.ParseDuration(fc.Pool.ConnMaxLifetime); err == nil {
+			cfg.ConnMaxLifetime = d
+		}
+	}
+	if fc.Session.Secret != "" {
+		cfg.SessionSecret = fc.Session.Secret
+	}
+}
+
+func applyEnv(cfg *Config) error {
+	if v := os.Getenv("DB_DRIVER"); v != "" {
+		cfg.Driver = v
+	}
+	if v := os.Getenv("DB_SQLITE_PATH"); v != "" {
+		cfg.SQLitePath = v
+	}
+	if v := os.Getenv("DB_HOST"); v != "" {
+		cfg.DBHost = v
+	}
+	if v := os.Getenv("DB_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid DB_PORT %q: %w", v, err)
+		}
+		cfg.DBPort = port
+	}
+	if v := os.Getenv("DB_USER"); v != "" {
+		cfg.DBUser = v
+	}
+	if v := os.Getenv("DB_PASSWORD"); v != "" {
+		cfg.DBPassword = v
+	}
+	if v := os.Getenv("DB_NAME"); v != "" {
+		cfg.DBName = v
+	}
+	if v := os.Getenv("SERVER_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid SERVER_PORT %q: %w", v, err)
+		}
+		cfg.ServerPort = port
+	}
+	if v := os.Getenv("MAX_OPEN_CONNS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid MAX_OPEN_CONNS %q: %w", v, err)
+		}
+		cfg.MaxOpenConns = n
+	}
+	if v := os.Getenv("MAX_IDLE_CONNS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid MAX_IDLE_CONNS %q: %w", v, err)
+		}
+		cfg.MaxIdleConns = n
+	}
+	if v := os.Getenv("CONN_MAX_LIFETIME"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid CONN_MAX_LIFETIME %q: %w", v, err)
+		}
+		cfg.ConnMaxLifetime = d
+	}
+	if v := os.Getenv("SESSION_SECRET"); v != "" {
+		cfg.SessionSecret = v
+	}
+	return nil
+}
+
+// DSN builds the MySQL data source name for this configuration.
+func (c Config) DSN() string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s", c.DBUser, c.DBPassword, c.DBHost, c.DBPort, c.DBName)
+}
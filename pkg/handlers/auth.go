@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/DazaSCM/GolangCURD/pkg/model"
+)
+
+const sessionName = "gocrud-session"
+
+type ctxKey string
+
+const userIDKey ctxKey = "userID"
+
+type registerRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type loginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// RequireAuth gates a handler behind a valid session, storing the
+// authenticated user's ID in the request context for downstream
+// handlers to read with currentUserID.
+func (a *App) RequireAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session, err := a.Sessions.Get(r, sessionName)
+		if err != nil {
+			respondWithError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+
+		userID, ok := session.Values["user_id"].(int)
+		if !ok {
+			respondWithError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), userIDKey, userID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func currentUserID(r *http.Request) (int, bool) {
+	id, ok := r.Context().Value(userIDKey).(int)
+	return id, ok
+}
+
+// authorizeMutation reports whether the authenticated user on r may
+// mutate the user identified by targetID: either they are that user, or
+// they hold the admin role. It writes the appropriate error response and
+// returns false otherwise.
+func (a *App) authorizeMutation(w http.ResponseWriter, r *http.Request, targetID int) bool {
+	userID, ok := currentUserID(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return false
+	}
+
+	if userID == targetID {
+		return true
+	}
+
+	current, err := a.Store.Get(r.Context(), userID)
+	if err != nil || !current.IsAdmin {
+		respondWithError(w, http.StatusForbidden, "Forbidden")
+		return false
+	}
+
+	return true
+}
+
+func (a *App) registerHandler(w http.ResponseWriter, r *http.Request) {
+	var req registerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid input")
+		return
+	}
+
+	user := model.User{Name: req.Name, Email: req.Email}
+	if err := user.Validate(); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Password == "" {
+		respondWithError(w, http.StatusBadRequest, "password is required")
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to create user")
+		return
+	}
+
+	if err := a.Store.Create(r.Context(), user.Name, user.Email, string(hash)); err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to create user")
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, map[string]string{"message": "User created successfully"})
+}
+
+func (a *App) loginHandler(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid input")
+		return
+	}
+
+	user, err := a.Store.GetByEmail(r.Context(), req.Email)
+	if err != nil {
+		respondWithError(w, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		respondWithError(w, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	session, _ := a.Sessions.Get(r, sessionName)
+	session.Values["user_id"] = user.ID
+	if err := session.Save(r, w); err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to start session")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, user)
+}
+
+func (a *App) logoutHandler(w http.ResponseWriter, r *http.Request) {
+	session, err := a.Sessions.Get(r, sessionName)
+	if err != nil {
+		respondWithJSON(w, http.StatusOK, map[string]string{"message": "Logged out"})
+		return
+	}
+
+	session.Options.MaxAge = -1
+	if err := session.Save(r, w); err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to end session")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Logged out"})
+}
+
+func (a *App) meHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := currentUserID(r)
+	if !ok {
+		respondWithError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	user, err := a.Store.Get(r.Context(), userID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, user)
+}
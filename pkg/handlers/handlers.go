@@ -0,0 +1,194 @@
+// Package handlers wires the HTTP routes to a store.UserStore.
+package handlers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/sessions"
+
+	"github.com/DazaSCM/GolangCURD/pkg/model"
+	"github.com/DazaSCM/GolangCURD/pkg/store"
+)
+
+// App owns the router, the store, the session store and the logger, and
+// exposes the HTTP handlers as methods so no handler needs to open its
+// own connection.
+type App struct {
+	Router   *mux.Router
+	Store    store.UserStore
+	Sessions sessions.Store
+	Logger   *log.Logger
+}
+
+// NewApp builds an App with all routes registered against the given
+// store. sessionSecret signs and encrypts the session cookie.
+func NewApp(s store.UserStore, logger *log.Logger, sessionSecret string) *App {
+	a := &App{
+		Router:   mux.NewRouter(),
+		Store:    s,
+		Sessions: sessions.NewCookieStore([]byte(sessionSecret)),
+		Logger:   logger,
+	}
+	a.routes()
+	a.Router.Use(a.LoggingMiddleware, a.RecoveryMiddleware)
+	return a
+}
+
+func (a *App) routes() {
+	a.Router.HandleFunc("/register", a.registerHandler).Methods("POST")
+	a.Router.HandleFunc("/login", a.loginHandler).Methods("POST")
+	a.Router.HandleFunc("/logout", a.logoutHandler).Methods("POST")
+	a.Router.Handle("/me", a.RequireAuth(http.HandlerFunc(a.meHandler))).Methods("GET")
+
+	a.Router.Handle("/users", a.RequireAuth(http.HandlerFunc(a.getAllUsersHandler))).Methods("GET")
+	a.Router.HandleFunc("/user", a.registerHandler).Methods("POST")
+	a.Router.HandleFunc("/user/{id}", a.getUserHandler).Methods("GET")
+	a.Router.Handle("/user/{id}", a.RequireAuth(http.HandlerFunc(a.updateUserHandler))).Methods("PUT")
+	a.Router.Handle("/user/{id}", a.RequireAuth(http.HandlerFunc(a.deleteUserHandler))).Methods("DELETE")
+}
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+type listUsersResponse struct {
+	Data    []model.User `json:"data"`
+	Page    int          `json:"page"`
+	PerPage int          `json:"per_page"`
+	Total   int          `json:"total"`
+}
+
+func (a *App) getAllUsersHandler(w http.ResponseWriter, r *http.Request) {
+	opts := parseListOptions(r)
+
+	users, total, err := a.Store.GetAll(r.Context(), opts)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to list users")
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	respondWithJSON(w, http.StatusOK, listUsersResponse{
+		Data:    users,
+		Page:    opts.Page,
+		PerPage: opts.PerPage,
+		Total:   total,
+	})
+}
+
+func parseListOptions(r *http.Request) store.ListOptions {
+	q := r.URL.Query()
+
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	perPage, err := strconv.Atoi(q.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	sort := q.Get("sort")
+	if !store.SortableColumns[sort] {
+		sort = "id"
+	}
+
+	order := strings.ToLower(q.Get("order"))
+	if order != "asc" && order != "desc" {
+		order = "asc"
+	}
+
+	return store.ListOptions{
+		Page:    page,
+		PerPage: perPage,
+		Sort:    sort,
+		Order:   order,
+		Query:   q.Get("q"),
+	}
+}
+
+func (a *App) getUserHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseID(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	user, err := a.Store.Get(r.Context(), userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		respondWithError(w, http.StatusNotFound, "User not found")
+		return
+	}
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to fetch user")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, user)
+}
+
+func (a *App) updateUserHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseID(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if !a.authorizeMutation(w, r, userID) {
+		return
+	}
+
+	var user model.User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid input")
+		return
+	}
+
+	if err := user.Validate(); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := a.Store.Update(r.Context(), userID, user.Name, user.Email); err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to update user")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "User updated successfully"})
+}
+
+func (a *App) deleteUserHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := parseID(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	if !a.authorizeMutation(w, r, userID) {
+		return
+	}
+
+	if err := a.Store.Delete(r.Context(), userID); err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to delete user")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "User deleted successfully"})
+}
+
+func parseID(r *http.Request) (int, error) {
+	vars := mux.Vars(r)
+	return strconv.Atoi(vars["id"])
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+)
+
+// statusRecorder wraps http.ResponseWriter to capture the status code
+// written so middleware can log it after the handler returns.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// LoggingMiddleware logs the method, path, status, duration and remote
+// address of every request.
+func (a *App) LoggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rec, r)
+
+		a.Logger.Printf("%s %s %d %s %s", r.Method, r.URL.Path, rec.status, time.Since(start), r.RemoteAddr)
+	})
+}
+
+// RecoveryMiddleware converts a panic in a downstream handler into a 500
+// JSON error response instead of taking down the server.
+func (a *App) RecoveryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+				a.Logger.Printf("panic handling %s %s: %v", r.Method, r.URL.Path, err)
+				respondWithError(w, http.StatusInternalServerError, "Internal server error")
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
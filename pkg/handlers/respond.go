@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// respondWithJSON writes payload as a JSON response with the given
+// status code.
+func respondWithJSON(w http.ResponseWriter, code int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// respondWithError writes a {"error": {"code", "message"}} JSON body
+// with the given status code.
+func respondWithError(w http.ResponseWriter, code int, message string) {
+	respondWithJSON(w, code, errorResponse{Error: errorBody{Code: code, Message: message}})
+}
@@ -0,0 +1,71 @@
+// Package migrate runs the embedded SQL migrations that create and
+// evolve the users schema.
+package migrate
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+
+	gomigrate "github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/mysql"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+func newMigrator(db *sql.DB) (*gomigrate.Migrate, error) {
+	driver, err := mysql.WithInstance(db, &mysql.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := iofs.New(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	return gomigrate.NewWithInstance("iofs", src, "mysql", driver)
+}
+
+// Up applies all pending migrations.
+func Up(db *sql.DB) error {
+	m, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Up(); err != nil && !errors.Is(err, gomigrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Down reverts all applied migrations.
+func Down(db *sql.DB) error {
+	m, err := newMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Down(); err != nil && !errors.Is(err, gomigrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Status reports the current schema version and whether the last
+// migration left the schema in a dirty state.
+func Status(db *sql.DB) (version uint, dirty bool, err error) {
+	m, err := newMigrator(db)
+	if err != nil {
+		return 0, false, err
+	}
+
+	version, dirty, err = m.Version()
+	if errors.Is(err, gomigrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// User represents an application user persisted by a store.UserStore.
+type User struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	PasswordHash string `json:"-"`
+	IsAdmin      bool   `json:"is_admin,omitempty"`
+}
+
+var emailRe = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// Validate checks if the User struct contains valid data.
+func (u *User) Validate() error {
+	if u.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if u.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+	if !emailRe.MatchString(u.Email) {
+		return fmt.Errorf("invalid email format")
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/DazaSCM/GolangCURD/pkg/model"
+)
+
+// MySQLStore is a UserStore backed by a MySQL database. Get, GetByEmail,
+// Create, Update and Delete run through prepared statements created once
+// at startup, so each request only pays for binding parameters and
+// round-tripping, not re-parsing SQL. GetAll is not prepared: it builds
+// its WHERE and ORDER BY clauses from ListOptions and sends its queries
+// unprepared on every call.
+type MySQLStore struct {
+	db *sql.DB
+
+	getStmt        *sql.Stmt
+	getByEmailStmt *sql.Stmt
+	createStmt     *sql.Stmt
+	updateStmt     *sql.Stmt
+	deleteStmt     *sql.Stmt
+}
+
+// NewMySQLStore wraps an already-opened *sql.DB (with its connection
+// pool and Ping check already applied by the caller) in a UserStore,
+// preparing its CRUD statements up front.
+func NewMySQLStore(db *sql.DB) (*MySQLStore, error) {
+	s := &MySQLStore{db: db}
+
+	var err error
+	if s.getStmt, err = db.Prepare("SELECT id, name, email, password_hash, is_admin FROM users WHERE id = ?"); err != nil {
+		return nil, fmt.Errorf("prepare get: %w", err)
+	}
+	if s.getByEmailStmt, err = db.Prepare("SELECT id, name, email, password_hash, is_admin FROM users WHERE email = ?"); err != nil {
+		return nil, fmt.Errorf("prepare getByEmail: %w", err)
+	}
+	if s.createStmt, err = db.Prepare("INSERT INTO users (name, email, password_hash) VALUES (?, ?, ?)"); err != nil {
+		return nil, fmt.Errorf("prepare create: %w", err)
+	}
+	if s.updateStmt, err = db.Prepare("UPDATE users SET name = ?, email = ? WHERE id = ?"); err != nil {
+		return nil, fmt.Errorf("prepare update: %w", err)
+	}
+	if s.deleteStmt, err = db.Prepare("DELETE FROM users WHERE id = ?"); err != nil {
+		return nil, fmt.Errorf("prepare delete: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *MySQLStore) GetAll(ctx context.Context, opts ListOptions) ([]model.User, int, error) {
+	where := ""
+	args := []interface{}{}
+	if opts.Query != "" {
+		where = "WHERE name LIKE ? OR email LIKE ?"
+		like := "%" + opts.Query + "%"
+		args = append(args, like, like)
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM users %s", where)
+	if err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf(
+		"SELECT id, name, email, password_hash, is_admin FROM users %s ORDER BY %s LIMIT ? OFFSET ?",
+		where, orderByClause(opts),
+	)
+	listArgs := append(append([]interface{}{}, args...), opts.PerPage, (opts.Page-1)*opts.PerPage)
+
+	rows, err := s.db.QueryContext(ctx, query, listArgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		var user model.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.IsAdmin); err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (s *MySQLStore) Get(ctx context.Context, id int) (*model.User, error) {
+	row := s.getStmt.QueryRowContext(ctx, id)
+	user := &model.User{}
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.IsAdmin); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *MySQLStore) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	row := s.getByEmailStmt.QueryRowContext(ctx, email)
+	user := &model.User{}
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.IsAdmin); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *MySQLStore) Create(ctx context.Context, name, email, passwordHash string) error {
+	_, err := s.createStmt.ExecContext(ctx, name, email, passwordHash)
+	return err
+}
+
+func (s *MySQLStore) Update(ctx context.Context, id int, name, email string) error {
+	_, err := s.updateStmt.ExecContext(ctx, name, email, id)
+	return err
+}
+
+func (s *MySQLStore) Delete(ctx context.Context, id int) error {
+	_, err := s.deleteStmt.ExecContext(ctx, id)
+	return err
+}
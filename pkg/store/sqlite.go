@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/DazaSCM/GolangCURD/pkg/model"
+)
+
+// SQLiteStore is a UserStore backed by SQLite. It is primarily useful
+// for tests and local development, where an in-memory database (DSN
+// ":memory:") avoids the need for a live MySQL instance. Like
+// MySQLStore, Get, GetByEmail, Create, Update and Delete run through
+// prepared statements created once at startup, while GetAll builds and
+// sends its queries unprepared on every call.
+type SQLiteStore struct {
+	db *sql.DB
+
+	getStmt        *sql.Stmt
+	getByEmailStmt *sql.Stmt
+	createStmt     *sql.Stmt
+	updateStmt     *sql.Stmt
+	deleteStmt     *sql.Stmt
+}
+
+// sqliteSchema creates the users table and its supporting index if they
+// don't already exist. The MySQL migration subsystem in pkg/migrate is
+// golang-migrate/MySQL-specific, so SQLite bootstraps its own version of
+// the schema here instead of sharing it; this version has no created_at
+// or updated_at columns. Callers never need to run "gocrud migrate"
+// against a SQLite database.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS users (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT NOT NULL,
+	email TEXT NOT NULL UNIQUE,
+	password_hash TEXT NOT NULL DEFAULT '',
+	is_admin BOOLEAN NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_users_email ON users (email);
+`
+
+// NewSQLiteStore opens a SQLite connection using the given DSN (e.g.
+// "./gocrud.db" or ":memory:"), bootstraps the users schema, prepares
+// its CRUD statements, and returns a store backed by it.
+func NewSQLiteStore(dsn string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// Every new connection to ":memory:" opens a separate, empty database,
+	// so keep a single connection that holds the bootstrapped schema.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		return nil, fmt.Errorf("bootstrap schema: %w", err)
+	}
+
+	s := &SQLiteStore{db: db}
+
+	if s.getStmt, err = db.Prepare("SELECT id, name, email, password_hash, is_admin FROM users WHERE id = ?"); err != nil {
+		return nil, fmt.Errorf("prepare get: %w", err)
+	}
+	if s.getByEmailStmt, err = db.Prepare("SELECT id, name, email, password_hash, is_admin FROM users WHERE email = ?"); err != nil {
+		return nil, fmt.Errorf("prepare getByEmail: %w", err)
+	}
+	if s.createStmt, err = db.Prepare("INSERT INTO users (name, email, password_hash) VALUES (?, ?, ?)"); err != nil {
+		return nil, fmt.Errorf("prepare create: %w", err)
+	}
+	if s.updateStmt, err = db.Prepare("UPDATE users SET name = ?, email = ? WHERE id = ?"); err != nil {
+		return nil, fmt.Errorf("prepare update: %w", err)
+	}
+	if s.deleteStmt, err = db.Prepare("DELETE FROM users WHERE id = ?"); err != nil {
+		return nil, fmt.Errorf("prepare delete: %w", err)
+	}
+
+	return s, nil
+}
+
+func (s *SQLiteStore) GetAll(ctx context.Context, opts ListOptions) ([]model.User, int, error) {
+	where := ""
+	args := []interface{}{}
+	if opts.Query != "" {
+		where = "WHERE name LIKE ? OR email LIKE ?"
+		like := "%" + opts.Query + "%"
+		args = append(args, like, like)
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM users %s", where)
+	if err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := fmt.Sprintf(
+		"SELECT id, name, email, password_hash, is_admin FROM users %s ORDER BY %s LIMIT ? OFFSET ?",
+		where, orderByClause(opts),
+	)
+	listArgs := append(append([]interface{}{}, args...), opts.PerPage, (opts.Page-1)*opts.PerPage)
+
+	rows, err := s.db.QueryContext(ctx, query, listArgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		var user model.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.IsAdmin); err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (s *SQLiteStore) Get(ctx context.Context, id int) (*model.User, error) {
+	row := s.getStmt.QueryRowContext(ctx, id)
+	user := &model.User{}
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.IsAdmin); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *SQLiteStore) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	row := s.getByEmailStmt.QueryRowContext(ctx, email)
+	user := &model.User{}
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.IsAdmin); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *SQLiteStore) Create(ctx context.Context, name, email, passwordHash string) error {
+	_, err := s.createStmt.ExecContext(ctx, name, email, passwordHash)
+	return err
+}
+
+func (s *SQLiteStore) Update(ctx context.Context, id int, name, email string) error {
+	_, err := s.updateStmt.ExecContext(ctx, name, email, id)
+	return err
+}
+
+func (s *SQLiteStore) Delete(ctx context.Context, id int) error {
+	_, err := s.deleteStmt.ExecContext(ctx, id)
+	return err
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"testing"
+)
+
+// newTestStore returns a SQLiteStore backed by a fresh in-memory
+// database, proving the store can be built and used without a live
+// MySQL instance.
+func newTestStore(t *testing.T) *SQLiteStore {
+	t.Helper()
+
+	s, err := NewSQLiteStore(":memory:")
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	t.Cleanup(func() { s.db.Close() })
+
+	return s
+}
+
+func TestSQLiteStoreCRUD(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+
+	if err := s.Create(ctx, "Ada Lovelace", "ada@example.com", "hash"); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	users, total, err := s.GetAll(ctx, ListOptions{Page: 1, PerPage: 10, Sort: "id", Order: "asc"})
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if total != 1 || len(users) != 1 {
+		t.Fatalf("GetAll: got total=%d len=%d, want 1 and 1", total, len(users))
+	}
+
+	created := users[0]
+	if created.Name != "Ada Lovelace" || created.Email != "ada@example.com" {
+		t.Fatalf("GetAll: got %+v, want Name/Email to match Create", created)
+	}
+
+	got, err := s.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Email != created.Email {
+		t.Fatalf("Get: got email %q, want %q", got.Email, created.Email)
+	}
+
+	byEmail, err := s.GetByEmail(ctx, created.Email)
+	if err != nil {
+		t.Fatalf("GetByEmail: %v", err)
+	}
+	if byEmail.ID != created.ID {
+		t.Fatalf("GetByEmail: got id %d, want %d", byEmail.ID, created.ID)
+	}
+
+	if err := s.Update(ctx, created.ID, "Ada King", created.Email); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	updated, err := s.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Get after Update: %v", err)
+	}
+	if updated.Name != "Ada King" {
+		t.Fatalf("Get after Update: got name %q, want %q", updated.Name, "Ada King")
+	}
+
+	if err := s.Delete(ctx, created.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get(ctx, created.ID); err == nil {
+		t.Fatal("Get after Delete: expected an error, got nil")
+	}
+}
@@ -0,0 +1,61 @@
+// Package store defines the persistence interface used by the HTTP
+// handlers and the concrete backends that implement it.
+package store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DazaSCM/GolangCURD/pkg/model"
+)
+
+// SortableColumns are the columns GetAll may order by. Keeping this list
+// in one place lets both backends validate ListOptions.Sort against the
+// same whitelist, which is what keeps the column name injection-safe.
+var SortableColumns = map[string]bool{
+	"id":    true,
+	"name":  true,
+	"email": true,
+}
+
+// ListOptions controls pagination, sorting and filtering for GetAll.
+// Sort should be one of SortableColumns and Order "asc" or "desc";
+// backends build the ORDER BY clause with orderByClause, which replaces
+// any other value with "id" or "asc".
+type ListOptions struct {
+	Page    int
+	PerPage int
+	Sort    string
+	Order   string
+	Query   string
+}
+
+// orderByClause turns ListOptions into a safe "column direction"
+// fragment, falling back to "id asc" when Sort/Order aren't set to one
+// of the whitelisted values.
+func orderByClause(opts ListOptions) string {
+	col := opts.Sort
+	if !SortableColumns[col] {
+		col = "id"
+	}
+
+	order := strings.ToLower(opts.Order)
+	if order != "asc" && order != "desc" {
+		order = "asc"
+	}
+
+	return col + " " + order
+}
+
+// UserStore is the persistence contract the handlers depend on. Each
+// backend (MySQL, SQLite, ...) implements it independently so the HTTP
+// layer never talks to database/sql directly. Every method takes the
+// request's context so a client disconnect cancels the underlying query.
+type UserStore interface {
+	GetAll(ctx context.Context, opts ListOptions) ([]model.User, int, error)
+	Get(ctx context.Context, id int) (*model.User, error)
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	Create(ctx context.Context, name, email, passwordHash string) error
+	Update(ctx context.Context, id int, name, email string) error
+	Delete(ctx context.Context, id int) error
+}